@@ -0,0 +1,74 @@
+// Copyright 2020 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package gcassert
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Analyzer drives gcassert's directive checks through the
+// golang.org/x/tools/go/analysis framework, so it can be run with
+// `go vet -vettool=gcassert`, bundled into a singlechecker/multichecker
+// binary, or wired into editor tooling like gopls. It reports the same
+// //gcassert directive failures as GCAssert, as diagnostics attached to the
+// offending token.Pos rather than lines printed to an io.Writer.
+//
+// The asm and noasm directives are not evaluated here: they require
+// disassembling a full build of the package via `go tool objdump`, which
+// doesn't fit the analysis framework's per-package, diagnostics-only
+// contract. Use the standalone GCAssert entry point for those.
+var Analyzer = &analysis.Analyzer{
+	Name: "gcassert",
+	Doc:  "check that //gcassert directives (inline, bce, noescape, escape, heap, opt, noopt) hold",
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	// collectDirectives keys byRelPath by the position fileSet already
+	// resolved for each directive's node, not by the file gcassert happened
+	// to parse: the compiler's -m diagnostics honor //line directives and
+	// report the file and line a directive points at (e.g. generated
+	// code's source template). It's the same helper parseDirectives uses
+	// for the standalone GCAssert entry point, so the two can't drift.
+	byRelPath := make(directiveMap)
+	collectDirectives(pass.Files, pass.Fset, pass.Pkg.Path(), cwd, byRelPath)
+	if len(byRelPath) == 0 {
+		return nil, nil
+	}
+
+	cmd := exec.Command("go", "build", "-gcflags=all=-m -m -d=ssa/check_bce/debug=1", pass.Pkg.Path())
+	cmd.Dir = cwd
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("building %s for gcassert analysis: %w\n%s", pass.Pkg.Path(), err, out.String())
+	}
+
+	report := func(info lineInfo, message string) error {
+		pass.Reportf(info.n.Pos(), "%s", message)
+		return nil
+	}
+	if err := evaluateDirectives(pass.Fset, byRelPath, &out, report); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
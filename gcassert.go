@@ -34,36 +34,109 @@ const (
 	noDirective assertDirective = iota
 	inline
 	bce
+	noescape
+	escape
+	heap
+	// opt and noopt are generic directives whose pass/fail behavior is
+	// entirely driven by the user-supplied regex carried on the directive
+	// struct, rather than by a hardcoded compiler message like the
+	// directives above.
+	opt
+	noopt
+	// asm and noasm are like opt and noopt, but match against the compiled
+	// machine code for the annotated line instead of a compiler diagnostic.
+	asm
+	noasm
 )
 
-func stringToDirective(s string) (assertDirective, error) {
-	switch s {
+// directive is a single parsed //gcassert: annotation. Most directive kinds
+// (inline, bce, ...) need nothing beyond their kind, but opt/noopt carry a
+// user-supplied regex that is matched against the raw compiler diagnostic
+// for the annotated line, and asm/noasm carry one that is matched against the
+// enclosing function's disassembly.
+type directive struct {
+	kind  assertDirective
+	regex *regexp.Regexp
+}
+
+// stringToDirective parses the text following "//gcassert:" into a
+// directive. Simple directives are a single word; opt, noopt, asm and noasm
+// additionally take a "/regex/" argument, e.g. "opt /canInline/".
+func stringToDirective(s string) (directive, error) {
+	s = strings.TrimSpace(s)
+	name, arg, _ := strings.Cut(s, " ")
+	arg = strings.TrimSpace(arg)
+	switch name {
 	case "inline":
-		return inline, nil
+		return directive{kind: inline}, nil
 	case "bce":
-		return bce, nil
+		return directive{kind: bce}, nil
+	case "noescape":
+		return directive{kind: noescape}, nil
+	case "escape":
+		return directive{kind: escape}, nil
+	case "heap":
+		return directive{kind: heap}, nil
+	case "opt", "noopt":
+		re, err := parseRegexArg(arg)
+		if err != nil {
+			return directive{}, err
+		}
+		kind := opt
+		if name == "noopt" {
+			kind = noopt
+		}
+		return directive{kind: kind, regex: re}, nil
+	case "asm", "noasm":
+		re, err := parseRegexArg(arg)
+		if err != nil {
+			return directive{}, err
+		}
+		kind := asm
+		if name == "noasm" {
+			kind = noasm
+		}
+		return directive{kind: kind, regex: re}, nil
+	}
+	return directive{}, errors.New(fmt.Sprintf("no such directive %s", name))
+}
+
+// parseRegexArg parses the "/regex/" argument taken by the opt, noopt, asm
+// and noasm directives.
+func parseRegexArg(arg string) (*regexp.Regexp, error) {
+	if len(arg) < 2 || arg[0] != '/' || arg[len(arg)-1] != '/' {
+		return nil, errors.New(fmt.Sprintf("expected a /regex/ argument, got %q", arg))
 	}
-	return noDirective, errors.New(fmt.Sprintf("no such directive %s", s))
+	return regexp.Compile(arg[1 : len(arg)-1])
 }
 
 type lineInfo struct {
 	n          ast.Node
-	directives []assertDirective
+	directives []directive
 	// passedDirective is a map from index into the directives slice to a
 	// boolean that says whether or not the directive succeeded, in the case
 	// of directives like inlining that have compiler output if they passed.
 	// For directives like bce that have compiler output if they failed, there's
 	// no entry in this map.
 	passedDirective map[int]bool
+	// fn is the function declaration enclosing this line, used by the asm
+	// and noasm directives to find the symbol to disassemble.
+	fn *ast.FuncDecl
+	// pkgPath is the import path of the package this line belongs to, used
+	// for the same reason as fn.
+	pkgPath string
 }
 
-var gcAssertRegex = regexp.MustCompile(`//gcassert:(\w+)`)
+var gcAssertRegex = regexp.MustCompile(`//gcassert:(.+)`)
 
 type assertVisitor struct {
 	commentMap ast.CommentMap
 
 	directiveMap map[int]lineInfo
 	fileSet      *token.FileSet
+	// currentFunc is the innermost *ast.FuncDecl containing the node
+	// currently being visited, or nil if we're not inside a function.
+	currentFunc *ast.FuncDecl
 }
 
 func newAssertVisitor(commentMap ast.CommentMap, fileSet *token.FileSet) assertVisitor {
@@ -78,6 +151,9 @@ func (v assertVisitor) Visit(node ast.Node) (w ast.Visitor) {
 	if node == nil {
 		return w
 	}
+	if fn, ok := node.(*ast.FuncDecl); ok {
+		v.currentFunc = fn
+	}
 	m := v.commentMap[node]
 COMMENTLOOP:
 	for _, g := range m {
@@ -89,15 +165,16 @@ COMMENTLOOP:
 			// The 0th match is the whole string, and the 1st match is the
 			// gcassert directive.
 
-			directive, err := stringToDirective(matches[1])
+			d, err := stringToDirective(matches[1])
 			if err != nil {
 				continue COMMENTLOOP
 			}
 			pos := node.Pos()
 			lineNumber := v.fileSet.Position(pos).Line
 			lineInfo := v.directiveMap[lineNumber]
-			lineInfo.directives = append(lineInfo.directives, directive)
+			lineInfo.directives = append(lineInfo.directives, d)
 			lineInfo.n = node
+			lineInfo.fn = v.currentFunc
 			v.directiveMap[lineNumber] = lineInfo
 		}
 	}
@@ -107,6 +184,59 @@ COMMENTLOOP:
 // GCAssert searches through the packages at the input path and writes failures
 // to comply with //gcassert directives to the given io.Writer.
 func GCAssert(path string, w io.Writer) error {
+	failures, err := checkPath(path)
+	if err != nil {
+		return err
+	}
+	for _, f := range failures {
+		if _, err := fmt.Fprintln(w, f.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Failure describes a single violated //gcassert directive.
+type Failure struct {
+	// File is the path to the annotated file, relative to the working
+	// directory gcassert ran from.
+	File string
+	// Line is the annotated line number within File.
+	Line int
+	// Source is the source text of the node the directive is attached to.
+	// For directives on multi-line constructs (e.g. a func decl), this is
+	// itself multi-line.
+	Source string
+	// Message is the compiler diagnostic, or synthesized reason, that
+	// proved the directive false.
+	Message string
+}
+
+// String formats f the same way GCAssert writes a failure to its
+// io.Writer.
+func (f Failure) String() string {
+	return fmt.Sprintf("%s:%d:\t%s: %s", f.File, f.Line, f.Source, f.Message)
+}
+
+// newFailure builds the Failure for info failing with the given message.
+func newFailure(cwd string, fileSet *token.FileSet, info lineInfo, message string) (Failure, error) {
+	var buf strings.Builder
+	if err := printer.Fprint(&buf, fileSet, info.n); err != nil {
+		return Failure{}, err
+	}
+	pos := fileSet.Position(info.n.Pos())
+	relPath, err := filepath.Rel(cwd, pos.Filename)
+	if err != nil {
+		return Failure{}, err
+	}
+	return Failure{File: relPath, Line: pos.Line, Source: buf.String(), Message: message}, nil
+}
+
+// checkPath runs the full gcassert pipeline (directive parsing, `go build
+// -gcflags=...`, and `go tool objdump` for asm/noasm directives) against
+// path and returns every violated directive as structured data. GCAssert
+// and Run are both thin wrappers around it.
+func checkPath(path string) ([]Failure, error) {
 	fileSet := token.NewFileSet()
 	pkgs, err := packages.Load(&packages.Config{
 		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedCompiledGoFiles,
@@ -114,7 +244,7 @@ func GCAssert(path string, w io.Writer) error {
 	}, path)
 	directiveMap, err := parseDirectives(pkgs, fileSet)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Next: invoke Go compiler with -m flags to get the compiler to print
@@ -124,7 +254,7 @@ func GCAssert(path string, w io.Writer) error {
 	cmd := exec.Command("go", args...)
 	cwd, err := os.Getwd()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	cmd.Dir = cwd
 	pr, pw := io.Pipe()
@@ -136,45 +266,130 @@ func GCAssert(path string, w io.Writer) error {
 		pw.Close()
 	}()
 
-	scanner := bufio.NewScanner(pr)
-	optInfo := regexp.MustCompile(`([\.\/\w]+):(\d+):\d+: (.*)`)
+	var failures []Failure
+	report := func(info lineInfo, message string) error {
+		f, err := newFailure(cwd, fileSet, info, message)
+		if err != nil {
+			return err
+		}
+		failures = append(failures, f)
+		return nil
+	}
+	if err := evaluateDirectives(fileSet, directiveMap, pr, report); err != nil {
+		return nil, err
+	}
+	if err := checkAsmDirectives(cwd, fileSet, directiveMap, report); err != nil {
+		return nil, err
+	}
+	return failures, nil
+}
+
+// evaluateDirectives reads the `go build -gcflags=...` diagnostics in r,
+// evaluates every bce/inline/escape/opt-family directive in directiveMap
+// against them, and invokes report for each one that fails. It is shared by
+// the standalone GCAssert entry point and by Analyzer, so both apply
+// exactly the same pass/fail rules; only how a failure is surfaced differs.
+func evaluateDirectives(
+	fileSet *token.FileSet, directiveMap directiveMap, r io.Reader,
+	report func(info lineInfo, message string) error,
+) error {
+	scanner := bufio.NewScanner(r)
+	// The column group is optional: positions derived from a column-less
+	// "//line file:line" directive (as goyacc and older codegen emit) make
+	// the compiler print "file:line: message" with no column at all, not
+	// "file:line:col: message".
+	optInfo := regexp.MustCompile(`([\.\/\w]+):(\d+):(?:\d+:)?\s*(.*)`)
 	boundsCheck := "Found IsInBounds"
 	sliceBoundsCheck := "Found SliceIsInBounds"
+	// escapesToHeap matches both the plain "X escapes to heap" summary (the
+	// form new()/composite-literal allocations get) and the colon-terminated
+	// "X escapes to heap:" form that precedes the verbose -m -m flow
+	// explanation for addressed locals.
+	escapesToHeap := regexp.MustCompile(`^(.+) escapes to heap:?$`)
+	movedToHeap := regexp.MustCompile(`^moved to heap: (.+)$`)
+	doesNotEscape := regexp.MustCompile(`^(.+) does not escape$`)
+
+	// heapEscape reports whether message is any of the compiler's forms of
+	// "this was heap-allocated", returning the identifier or expression text
+	// it names. A given heap allocation is reported as either "moved to
+	// heap: X" (addressed locals) or "X escapes to heap" (new() calls and
+	// composite literals) depending on the construct; under -m -m an
+	// addressed local may additionally get the "X escapes to heap:"
+	// flow-explanation header. noescape/heap both treat either form as
+	// evidence.
+	heapEscape := func(message string) (string, bool) {
+		if m := escapesToHeap.FindStringSubmatch(message); m != nil {
+			return m[1], true
+		}
+		if m := movedToHeap.FindStringSubmatch(message); m != nil {
+			return m[1], true
+		}
+		return "", false
+	}
 
 	for scanner.Scan() {
 		line := scanner.Text()
 		matches := optInfo.FindStringSubmatch(line)
-		if len(matches) != 0 {
-			path := matches[1]
-			lineNo, err := strconv.Atoi(matches[2])
-			if err != nil {
-				return err
-			}
-			message := matches[3]
+		if len(matches) == 0 {
+			continue
+		}
+		path := matches[1]
+		lineNo, err := strconv.Atoi(matches[2])
+		if err != nil {
+			return err
+		}
+		message := matches[3]
 
-			if lineToDirectives := directiveMap[path]; lineToDirectives != nil {
-				info := lineToDirectives[lineNo]
-				if info.passedDirective == nil {
-					info.passedDirective = make(map[int]bool)
-					lineToDirectives[lineNo] = info
+		lineToDirectives := directiveMap[path]
+		if lineToDirectives == nil {
+			continue
+		}
+		info := lineToDirectives[lineNo]
+		if info.passedDirective == nil {
+			info.passedDirective = make(map[int]bool)
+			lineToDirectives[lineNo] = info
+		}
+		for i, d := range info.directives {
+			switch d.kind {
+			case bce:
+				if message == boundsCheck || message == sliceBoundsCheck {
+					// Error! We found a bounds check where the user expected
+					// there to be none.
+					// Print out the user's code lineNo that failed the assertion,
+					// the assertion itself, and the compiler output that
+					// proved that the assertion failed.
+					if err := report(info, message); err != nil {
+						return err
+					}
 				}
-				for i, d := range info.directives {
-					switch d {
-					case bce:
-						if message == boundsCheck || message == sliceBoundsCheck {
-							// Error! We found a bounds check where the user expected
-							// there to be none.
-							// Print out the user's code lineNo that failed the assertion,
-							// the assertion itself, and the compiler output that
-							// proved that the assertion failed.
-							if err := printAssertionFailure(cwd, fileSet, info, w, message); err != nil {
-								return err
-							}
-						}
-					case inline:
-						if strings.HasPrefix(message, "inlining call to") {
-							info.passedDirective[i] = true
-						}
+			case inline:
+				if strings.HasPrefix(message, "inlining call to") {
+					info.passedDirective[i] = true
+				}
+			case noescape:
+				if ident, ok := heapEscape(message); ok && identMatchesNode(fileSet, info.n, ident) {
+					if err := report(info, message); err != nil {
+						return err
+					}
+				}
+			case escape:
+				if m := doesNotEscape.FindStringSubmatch(message); m != nil && identMatchesNode(fileSet, info.n, m[1]) {
+					if err := report(info, message); err != nil {
+						return err
+					}
+				}
+			case heap:
+				if ident, ok := heapEscape(message); ok && identMatchesNode(fileSet, info.n, ident) {
+					info.passedDirective[i] = true
+				}
+			case opt:
+				if d.regex.MatchString(message) {
+					info.passedDirective[i] = true
+				}
+			case noopt:
+				if d.regex.MatchString(message) {
+					if err := report(info, message); err != nil {
+						return err
 					}
 				}
 			}
@@ -187,10 +402,30 @@ func GCAssert(path string, w io.Writer) error {
 				// An inlining directive passes if it has compiler output. For
 				// each inlining directive, check if there was matching compiler
 				// output and fail if not.
-				if d == inline {
+				if d.kind == inline {
+					if !info.passedDirective[i] {
+						if err := report(info, "call was not inlined"); err != nil {
+							return err
+						}
+					}
+				}
+				// A heap directive passes if the compiler reported the
+				// annotated allocation as moved to the heap. If we never saw
+				// that output, the allocation stayed on the stack and the
+				// assertion fails.
+				if d.kind == heap {
 					if !info.passedDirective[i] {
-						if err := printAssertionFailure(
-							cwd, fileSet, info, w, "call was not inlined"); err != nil {
+						if err := report(info, "was not moved to heap"); err != nil {
+							return err
+						}
+					}
+				}
+				// An opt directive passes if at least one compiler
+				// diagnostic on the annotated line matched the user's
+				// regex. If we never saw a match, the assertion fails.
+				if d.kind == opt {
+					if !info.passedDirective[i] {
+						if err := report(info, fmt.Sprintf("no compiler diagnostic matched /%s/", d.regex)); err != nil {
 							return err
 						}
 					}
@@ -201,18 +436,160 @@ func GCAssert(path string, w io.Writer) error {
 	return nil
 }
 
-func printAssertionFailure(cwd string, fileSet *token.FileSet, info lineInfo, w io.Writer, message string) error {
-	var buf strings.Builder
-	_ = printer.Fprint(&buf, fileSet, info.n)
-	pos := fileSet.Position(info.n.Pos())
-	relPath, err := filepath.Rel(cwd, pos.Filename)
-	if err != nil {
-		return err
+// asmLineRegex matches a single disassembled instruction line emitted by
+// `go tool objdump -s`, e.g.:
+//
+//	  foo.go:12		0x45e980		4c8d1424		LEAQ 0(SP), R12
+var asmLineRegex = regexp.MustCompile(`^\s*\S+\.go:\d+\s+0x[0-9a-f]+\s+(?:[0-9a-f]+\s+)?(.*)$`)
+
+// checkAsmDirectives evaluates the asm and noasm directives in directiveMap.
+// Unlike the other directives, which are checked against the `-m` compiler
+// diagnostics already being scanned in GCAssert, asm/noasm need the actual
+// machine code for the enclosing function, so this builds each package
+// containing such a directive and disassembles it with `go tool objdump`.
+func checkAsmDirectives(
+	cwd string, fileSet *token.FileSet, directiveMap directiveMap,
+	report func(info lineInfo, message string) error,
+) error {
+	type target struct {
+		info lineInfo
+		idx  int
+	}
+	targetsByPkg := make(map[string][]target)
+	for _, lineToDirectives := range directiveMap {
+		for _, info := range lineToDirectives {
+			for i, d := range info.directives {
+				if d.kind == asm || d.kind == noasm {
+					targetsByPkg[info.pkgPath] = append(targetsByPkg[info.pkgPath], target{info: info, idx: i})
+				}
+			}
+		}
+	}
+
+	for pkgPath, targets := range targetsByPkg {
+		bin, err := os.CreateTemp("", "gcassert-asm-")
+		if err != nil {
+			return err
+		}
+		bin.Close()
+		defer os.Remove(bin.Name())
+
+		build := exec.Command("go", "build", "-o", bin.Name(), pkgPath)
+		build.Dir = cwd
+		if out, err := build.CombinedOutput(); err != nil {
+			return fmt.Errorf("building %s to check asm directives: %w\n%s", pkgPath, err, out)
+		}
+
+		symbols := make(map[string]struct{})
+		for _, t := range targets {
+			if t.info.fn != nil {
+				symbols[funcSymbol(pkgPath, t.info.fn)] = struct{}{}
+			}
+		}
+
+		// The directive asserts a pattern appears somewhere in the compiled
+		// function containing the annotated line (e.g. a //gcassert:asm
+		// comment above a func, or anywhere in a hot loop's body), not on
+		// that exact source line -- objdump attributes most instructions to
+		// statement lines inside the body, never to the func line itself.
+		// `-s sym` already scopes the dump to that one function, so we can
+		// collect every instruction it contains without bucketing by line.
+		insnsBySymbol := make(map[string][]string)
+		for sym := range symbols {
+			dump := exec.Command("go", "tool", "objdump", "-s", sym, bin.Name())
+			out, err := dump.CombinedOutput()
+			if err != nil {
+				return fmt.Errorf("objdump %s: %w\n%s", sym, err, out)
+			}
+			for _, line := range strings.Split(string(out), "\n") {
+				m := asmLineRegex.FindStringSubmatch(line)
+				if m == nil {
+					continue
+				}
+				insnsBySymbol[sym] = append(insnsBySymbol[sym], m[1])
+			}
+		}
+
+		for _, t := range targets {
+			d := t.info.directives[t.idx]
+			var insns []string
+			if t.info.fn != nil {
+				insns = insnsBySymbol[funcSymbol(pkgPath, t.info.fn)]
+			}
+			matched := false
+			for _, insn := range insns {
+				if d.regex.MatchString(insn) {
+					matched = true
+					break
+				}
+			}
+			switch d.kind {
+			case asm:
+				if !matched {
+					if err := report(t.info, fmt.Sprintf("no instruction matched /%s/", d.regex)); err != nil {
+						return err
+					}
+				}
+			case noasm:
+				if matched {
+					if err := report(t.info, fmt.Sprintf("an instruction matched /%s/", d.regex)); err != nil {
+						return err
+					}
+				}
+			}
+		}
 	}
-	fmt.Fprintf(w, "%s:%d:\t%s: %s\n", relPath, pos.Line, buf.String(), message)
 	return nil
 }
 
+// funcSymbol returns the symbol name `go tool objdump` uses for the given
+// function declaration in the package at pkgPath, e.g. "pkg.Foo" or
+// "pkg.(*T).Foo" for a pointer-receiver method.
+func funcSymbol(pkgPath string, fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return pkgPath + "." + fn.Name.Name
+	}
+	recvType := fn.Recv.List[0].Type
+	star := false
+	if se, ok := recvType.(*ast.StarExpr); ok {
+		star = true
+		recvType = se.X
+	}
+	typeName := "?"
+	if id, ok := recvType.(*ast.Ident); ok {
+		typeName = id.Name
+	}
+	if star {
+		return fmt.Sprintf("%s.(*%s).%s", pkgPath, typeName, fn.Name.Name)
+	}
+	return fmt.Sprintf("%s.%s.%s", pkgPath, typeName, fn.Name.Name)
+}
+
+// identMatchesNode reports whether the given identifier, as reported by the
+// compiler's escape analysis diagnostics, refers to the variable or
+// parameter described by n. Escape diagnostics are keyed by line number, and
+// a single line can declare or reference more than one identifier (e.g.
+// `x, y := f(), g()`), so we confirm the reported identifier actually
+// appears in the source text of the annotated node before treating the
+// diagnostic as a match.
+func identMatchesNode(fileSet *token.FileSet, n ast.Node, ident string) bool {
+	ident = strings.TrimPrefix(ident, "&")
+	var buf strings.Builder
+	if err := printer.Fprint(&buf, fileSet, n); err != nil {
+		return false
+	}
+	// Compiler messages for expressions like new(int) or &T{A: 1} echo the
+	// source text verbatim but aren't guaranteed to space it exactly like
+	// go/printer does, so compare with whitespace squeezed out rather than
+	// requiring an exact substring match.
+	return strings.Contains(squeeze(buf.String()), squeeze(ident))
+}
+
+// squeeze removes all whitespace from s.
+func squeeze(s string) string {
+	return strings.Join(strings.Fields(s), "")
+}
+
 type directiveMap map[string]map[int]lineInfo
 
 func parseDirectives(pkgs []*packages.Package, fileSet *token.FileSet) (directiveMap, error) {
@@ -222,22 +599,52 @@ func parseDirectives(pkgs []*packages.Package, fileSet *token.FileSet) (directiv
 		return nil, err
 	}
 	for _, pkg := range pkgs {
-		for i, file := range pkg.Syntax {
-			commentMap := ast.NewCommentMap(fileSet, file, file.Comments)
-
-			v := newAssertVisitor(commentMap, fileSet)
-			// First: find all lines of code annotated with our gcassert directives.
-			ast.Walk(v, file)
-
-			if len(v.directiveMap) > 0 {
-				absPath := pkg.CompiledGoFiles[i]
-				relPath, err := filepath.Rel(cwd, absPath)
-				if err != nil {
-					return nil, err
-				}
-				fileDirectiveMap[relPath] = v.directiveMap
+		collectDirectives(pkg.Syntax, fileSet, pkg.PkgPath, cwd, fileDirectiveMap)
+	}
+	return fileDirectiveMap, nil
+}
+
+// collectDirectives walks files, finds every //gcassert directive in them,
+// and keys each one into into by the file:line the compiler will report
+// diagnostics against. It's shared by parseDirectives (for the standalone
+// GCAssert/checkPath entry point, which sees one *packages.Package at a
+// time) and Analyzer's run (which sees one *analysis.Pass at a time), so the
+// two entry points can't drift on how directives are found or keyed.
+func collectDirectives(files []*ast.File, fileSet *token.FileSet, pkgPath, cwd string, into directiveMap) {
+	for _, file := range files {
+		commentMap := ast.NewCommentMap(fileSet, file, file.Comments)
+
+		v := newAssertVisitor(commentMap, fileSet)
+		// First: find all lines of code annotated with our gcassert directives.
+		ast.Walk(v, file)
+
+		for _, info := range v.directiveMap {
+			info.pkgPath = pkgPath
+
+			// The compiler's -m diagnostics honor //line directives the
+			// same way its build errors do, and report the file and
+			// line the directive points at (e.g. the .y grammar a
+			// parser was generated from) rather than the physical .go
+			// file we just parsed. fileSet.Position already resolved
+			// that for us when Visit computed this node's line number;
+			// key the directive map the same way, or an assertion
+			// written against generated code would never see a
+			// matching compiler message.
+			pos := fileSet.Position(info.n.Pos())
+			relPath, err := filepath.Rel(cwd, pos.Filename)
+			if err != nil {
+				// A bare //line target (e.g. "parser.y") has already
+				// been joined onto the physical file's directory, as the
+				// compiler also does, so Rel normally succeeds; if it
+				// doesn't, fall back to the filename verbatim.
+				relPath = pos.Filename
 			}
+			lineMap := into[relPath]
+			if lineMap == nil {
+				lineMap = make(map[int]lineInfo)
+				into[relPath] = lineMap
+			}
+			lineMap[pos.Line] = info
 		}
 	}
-	return fileDirectiveMap, nil
 }
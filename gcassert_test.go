@@ -0,0 +1,113 @@
+// Copyright 2020 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package gcassert
+
+import (
+	"strings"
+	"testing"
+)
+
+// failuresByLine runs the full checkPath pipeline against path and indexes
+// the result by annotated line number, so tests can assert on exactly the
+// lines they care about without depending on failure ordering.
+func failuresByLine(t *testing.T, path string) map[int]Failure {
+	t.Helper()
+	failures, err := checkPath(path)
+	if err != nil {
+		t.Fatalf("checkPath(%q): %v", path, err)
+	}
+	byLine := make(map[int]Failure, len(failures))
+	for _, f := range failures {
+		byLine[f.Line] = f
+	}
+	return byLine
+}
+
+// TestEscapeDirectives exercises noescape/escape/heap against testdata/escape,
+// which is the regression fixture for the heap-escape message forms
+// (`escapes to heap[:]` vs `moved to heap: `) fixed in chunk0-1.
+func TestEscapeDirectives(t *testing.T) {
+	byLine := failuresByLine(t, "./testdata/escape")
+
+	for _, line := range []int{18, 35, 45} {
+		if f, ok := byLine[line]; ok {
+			t.Errorf("line %d: unexpected failure: %s", line, f.Message)
+		}
+	}
+
+	f, ok := byLine[27]
+	if !ok {
+		t.Fatalf("line 27: want a noescape failure for EscapesToHeap, got none")
+	}
+	if !strings.Contains(f.Message, "heap") {
+		t.Errorf("line 27: failure message %q doesn't mention the heap", f.Message)
+	}
+}
+
+// TestOptDirectives exercises the opt/noopt user-regex directives against
+// testdata/opt: both are anchored to the same "can inline" diagnostic, so
+// opt should pass and noopt should fail on it.
+func TestOptDirectives(t *testing.T) {
+	byLine := failuresByLine(t, "./testdata/opt")
+
+	if f, ok := byLine[18]; ok {
+		t.Errorf("line 18: unexpected failure for Inlinable's opt directive: %s", f.Message)
+	}
+
+	f, ok := byLine[26]
+	if !ok {
+		t.Fatalf("line 26: want a noopt failure for AlsoInlinable, got none")
+	}
+	if !strings.Contains(f.Message, "can inline") {
+		t.Errorf("line 26: failure message %q doesn't mention the matched diagnostic", f.Message)
+	}
+}
+
+// TestAsmDirectives exercises the asm/noasm directives against testdata/asm.
+// Both are anchored to "RET", which every compiled function contains, so asm
+// should pass and noasm should fail on it -- this is the regression fixture
+// for chunk0-3, which matched only the exact annotated line instead of the
+// whole enclosing function's disassembly.
+func TestAsmDirectives(t *testing.T) {
+	byLine := failuresByLine(t, "./testdata/asm")
+
+	if f, ok := byLine[19]; ok {
+		t.Errorf("line 19: unexpected failure for Returns1's asm directive: %s", f.Message)
+	}
+
+	f, ok := byLine[28]
+	if !ok {
+		t.Fatalf("line 28: want a noasm failure for AlsoReturns1, got none")
+	}
+	if !strings.Contains(f.Message, "RET") {
+		t.Errorf("line 28: failure message %q doesn't mention the matched instruction", f.Message)
+	}
+}
+
+// TestLineDirective exercises bce against testdata/lined, whose //line
+// directive remaps every position in the physical file to a logical
+// template.src. This is the regression fixture for chunk0-5: the directive
+// map and the compiler's -m diagnostics must agree on template.src, not the
+// physical lined.go, or the failure below is silently dropped. Both resolve
+// the relative //line target against the physical file's directory, so the
+// failure is reported at testdata/lined/template.src.
+func TestLineDirective(t *testing.T) {
+	failures, err := checkPath("./testdata/lined")
+	if err != nil {
+		t.Fatalf("checkPath: %v", err)
+	}
+	for _, f := range failures {
+		if f.File == "testdata/lined/template.src" && f.Line == 10 {
+			return
+		}
+	}
+	t.Fatalf("want a bce failure at testdata/lined/template.src:10, got %+v", failures)
+}
@@ -0,0 +1,30 @@
+// Copyright 2020 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+// Package asm is fixture data for TestAsmDirectives.
+package asm
+
+// Returns1 is trivial enough that its compiled body always ends in a RET
+// instruction, regardless of platform or compiler version, which makes it a
+// deterministic fixture for asm/noasm.
+
+//gcassert:asm /RET/
+func Returns1() int {
+	return 1
+}
+
+// AlsoReturns1 is the same shape as Returns1, but annotated with noasm for
+// the same pattern, so the assertion should fail: every compiled function
+// contains a RET.
+
+//gcassert:noasm /RET/
+func AlsoReturns1() int {
+	return 1
+}
@@ -0,0 +1,24 @@
+// Copyright 2020 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+// Package clean is fixture data for TestTestPackage. Unlike the other
+// testdata packages, every directive here is expected to pass, so it doubles
+// as a smoke test that TestPackage stays quiet when there's nothing to
+// report.
+package clean
+
+// UsesInline calls inlineable in a context the compiler should inline, so
+// the inline directive passes.
+func UsesInline() int {
+	//gcassert:inline
+	return inlineable()
+}
+
+func inlineable() int { return 1 }
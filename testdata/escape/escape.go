@@ -0,0 +1,46 @@
+// Copyright 2020 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+// Package escape is fixture data for TestEscapeDirectives. It is not meant
+// to be imported; go vet/build diagnostics on it are the whole point.
+package escape
+
+// StaysOnStack never takes x's address, so it should never escape.
+func StaysOnStack() int {
+	//gcassert:noescape
+	x := 5
+	return x
+}
+
+// EscapesToHeap takes x's address and returns it, so x must escape despite
+// the (wrong) assertion that it doesn't. This is the regression fixture for
+// the noescape false-negative fixed in chunk0-1.
+func EscapesToHeap() *int {
+	//gcassert:noescape
+	x := 5
+	return &x
+}
+
+// WantsToEscape asserts the opposite of StaysOnStack: x's address is taken
+// and returned, so the compiler must report it as escaping.
+func WantsToEscape() *int {
+	//gcassert:escape
+	x := 5
+	return &x
+}
+
+// NewOnHeap allocates with new and returns the pointer, so the allocation
+// can't be stack-allocated. This is the regression fixture for the heap
+// false-negative fixed in chunk0-1: new(int)/composite-literal allocations
+// are reported as "X escapes to heap", never "moved to heap: X".
+func NewOnHeap() *int {
+	//gcassert:heap
+	return new(int)
+}
@@ -0,0 +1,26 @@
+// Copyright 2020 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+// Package lined is fixture data for TestLineDirective. It stands in for a
+// generated file (protobuf, goyacc, ...) whose //line directive remaps it to
+// a logical source -- template.src below -- that the physical file on disk
+// never has.
+//line template.src:1
+package lined
+
+// NotBoundsChecked indexes s without anything proving i is in range, so bce
+// should fail. Its directive and failing statement live in this physical
+// file, but //line remaps every position from here on to template.src, which
+// is what both the directive map and the compiler's -m diagnostics must
+// agree on for the assertion to be evaluated at all.
+func NotBoundsChecked(s []int, i int) int {
+	//gcassert:bce
+	return s[i]
+}
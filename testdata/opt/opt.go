@@ -0,0 +1,28 @@
+// Copyright 2020 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+// Package opt is fixture data for TestOptDirectives.
+package opt
+
+// Inlinable is small enough that the compiler reports it with a "can inline"
+// diagnostic, which the opt directive's regex matches.
+
+//gcassert:opt /can inline/
+func Inlinable() int {
+	return 1
+}
+
+// AlsoInlinable is the same shape as Inlinable, but annotated with noopt for
+// the pattern it's expected to match, so the assertion should fail.
+
+//gcassert:noopt /can inline/
+func AlsoInlinable() int {
+	return 2
+}
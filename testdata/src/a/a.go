@@ -0,0 +1,20 @@
+// Copyright 2020 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+// Package a is fixture data for TestAnalyzer.
+package a
+
+// NotBoundsChecked indexes s without anything proving i is in range, so the
+// bce directive should fail with the compiler's own "Found IsInBounds"
+// diagnostic, reported through pass.Reportf at the annotated node.
+func NotBoundsChecked(s []int, i int) int {
+	//gcassert:bce
+	return s[i] // want "Found IsInBounds"
+}
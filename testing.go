@@ -0,0 +1,71 @@
+// Copyright 2020 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package gcassert
+
+import (
+	"testing"
+)
+
+// Config configures a Run of gcassert's directive checks.
+type Config struct {
+	// Paths are the package directories to check, relative to the working
+	// directory and written with a leading "./" (e.g. "./pkg/hotpath"),
+	// exactly as accepted by GCAssert. Import paths are not accepted.
+	Paths []string
+}
+
+// Result is the outcome of a Run.
+type Result struct {
+	// Failures holds one entry per violated directive, formatted the same
+	// way GCAssert writes a failure to its io.Writer. A single entry may
+	// itself span multiple lines when the directive is attached to a
+	// multi-line node, like a func decl.
+	Failures []string
+}
+
+// Run checks the //gcassert directives in cfg.Paths and returns every
+// failure, without writing anything to an io.Writer. It's the same pipeline
+// GCAssert drives, exposed as a library call for callers, like TestPackage,
+// that want the failures as data rather than formatted output. Unlike
+// scraping GCAssert's io.Writer output, this parses failures structurally,
+// so a single violation on a multi-line node is never mistaken for several.
+func Run(cfg Config) (Result, error) {
+	var result Result
+	for _, path := range cfg.Paths {
+		failures, err := checkPath(path)
+		if err != nil {
+			return Result{}, err
+		}
+		for _, f := range failures {
+			result.Failures = append(result.Failures, f.String())
+		}
+	}
+	return result, nil
+}
+
+// TestPackage runs gcassert's directive checks against importPaths and
+// reports any failure via t.Errorf, so //gcassert directives can live in
+// *_test.go files and be checked as part of the normal test suite instead
+// of a separate CI script. For example:
+//
+//	func TestNoAllocs(t *testing.T) {
+//		gcassert.TestPackage(t, "./pkg/hotpath")
+//	}
+func TestPackage(t *testing.T, importPaths ...string) {
+	t.Helper()
+	result, err := Run(Config{Paths: importPaths})
+	if err != nil {
+		t.Fatalf("gcassert: %v", err)
+	}
+	for _, failure := range result.Failures {
+		t.Errorf("%s", failure)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2020 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package gcassert
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestRun exercises Run against testdata/opt, whose AlsoInlinable directive
+// is attached to a multi-line *ast.FuncDecl. This is the regression fixture
+// for chunk0-6: Run used to split a failure's writer-formatted output on
+// "\n", which turned one multi-line failure into several bogus entries.
+func TestRun(t *testing.T) {
+	result, err := Run(Config{Paths: []string{"./testdata/opt"}})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(result.Failures) != 1 {
+		t.Fatalf("want exactly 1 failure for a multi-line func decl, got %d: %v",
+			len(result.Failures), result.Failures)
+	}
+	if !strings.Contains(result.Failures[0], "func AlsoInlinable") {
+		t.Errorf("failure %q lost the multi-line source of the annotated func decl", result.Failures[0])
+	}
+}
+
+// TestTestPackage confirms TestPackage reports nothing via t.Errorf when
+// every directive in the checked packages passes.
+func TestTestPackage(t *testing.T) {
+	TestPackage(t, "./testdata/clean")
+}